@@ -1,84 +1,258 @@
 package main
 
 import (
-	"encoding/base64"
+	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
-)
+	"path/filepath"
+	"strings"
+	"time"
 
-type JiraIssue struct {
-	Key    string `json:"key"`
-	Fields struct {
-		Summary     string `json:"summary"`
-		Description string `json:"description"`
-		Status      struct {
-			Name string `json:"name"`
-		} `json:"status"`
-	} `json:"fields"`
-}
+	"gopkg.in/yaml.v3"
 
-type JiraResponse struct {
-	Issues []JiraIssue `json:"issues"`
-}
+	"github.com/adavila0703/dev-context/pkg/jira"
+	"github.com/adavila0703/dev-context/pkg/store"
+)
 
 func main() {
-	email := os.Getenv("JIRA_EMAIL")
-	apiToken := os.Getenv("JIRA_API_TOKEN")
+	jqlFlag := flag.String("jql", "", "JQL filter (overrides -project); if it doesn't include an ORDER BY clause, \"ORDER BY created DESC\" is appended")
+	projectFlag := flag.String("project", "", "Project key to search; used to build a default JQL filter if -jql is not set")
+	fieldsFlag := flag.String("fields", "", "Comma-separated list of fields to fetch, e.g. assignee,priority,labels")
+	maxFlag := flag.Int("max", 50, "Maximum number of issues to fetch (ignored if -all is set)")
+	allFlag := flag.Bool("all", false, "Follow pagination until every matching issue has been fetched")
+	exportFlag := flag.String("export", "", "Directory to export fetched issues to as JSON + Markdown")
+	authFlag := flag.String("auth", "basic", "Authentication method: basic, bearer, or oauth1")
+	createFlag := flag.String("create", "", "Create an issue from a YAML (.yaml/.yml) or JSON descriptor file and print its key")
+	commentFlag := flag.String("comment", "", "Issue key to comment on (used with -body)")
+	bodyFlag := flag.String("body", "", "Comment body (used with -comment)")
+	transitionFlag := flag.String("transition", "", "Issue key to transition (used with -to)")
+	toFlag := flag.String("to", "", "Target status name (used with -transition)")
+	timeoutFlag := flag.Duration("timeout", 30*time.Second, "Timeout for the whole operation")
+	qpsFlag := flag.Float64("qps", 0, "Client-side max requests per second against Jira (0 = unlimited)")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeoutFlag)
+	defer cancel()
+
 	jiraDomain := os.Getenv("JIRA_DOMAIN")
+	if jiraDomain == "" {
+		fmt.Println("Please set the JIRA_DOMAIN environment variable")
+		os.Exit(1)
+	}
 
-	if email == "" || apiToken == "" || jiraDomain == "" {
-		fmt.Println("Please set JIRA_EMAIL, JIRA_API_TOKEN, and JIRA_DOMAIN environment variables")
-		return
+	authenticator, err := buildAuthenticator(*authFlag)
+	if err != nil {
+		fmt.Printf("Error configuring authentication: %v\n", err)
+		os.Exit(1)
 	}
 
-	auth := base64.StdEncoding.EncodeToString([]byte(email + ":" + apiToken))
+	client, err := jira.NewClient("", "", jiraDomain,
+		jira.WithAuthenticator(authenticator),
+		jira.WithRateLimit(*qpsFlag),
+	)
+	if err != nil {
+		fmt.Printf("Error creating client: %v\n", err)
+		os.Exit(1)
+	}
 
-	client := &http.Client{}
-	jql := "project = YOUR_PROJECT_KEY ORDER BY created DESC"
-	url := fmt.Sprintf("https://%s/rest/api/2/search?jql=%s", jiraDomain, jql)
+	switch {
+	case *createFlag != "":
+		if err := runCreate(ctx, client, *createFlag); err != nil {
+			fmt.Printf("Error creating issue: %v\n", err)
+			os.Exit(1)
+		}
+		return
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		fmt.Printf("Error creating request: %v\n", err)
+	case *commentFlag != "":
+		if err := client.AddComment(ctx, *commentFlag, *bodyFlag); err != nil {
+			fmt.Printf("Error adding comment: %v\n", err)
+			os.Exit(1)
+		}
+		return
+
+	case *transitionFlag != "":
+		if err := client.TransitionIssue(ctx, *transitionFlag, *toFlag); err != nil {
+			fmt.Printf("Error transitioning issue: %v\n", err)
+			os.Exit(1)
+		}
 		return
 	}
 
-	req.Header.Add("Authorization", "Basic "+auth)
-	req.Header.Add("Content-Type", "application/json")
+	filter := *jqlFlag
+	hasOrderBy := strings.Contains(strings.ToUpper(filter), "ORDER BY")
+	if filter == "" {
+		project := *projectFlag
+		if project == "" {
+			project = "YOUR_PROJECT_KEY"
+		}
+		filter = fmt.Sprintf("project = %s", project)
+	}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		fmt.Printf("Error making request: %v\n", err)
-		return
+	var issueStore *store.IssueStore
+	if *exportFlag != "" {
+		issueStore, err = store.NewIssueStore(*exportFlag)
+		if err != nil {
+			fmt.Printf("Error creating export store: %v\n", err)
+			os.Exit(1)
+		}
+
+		if last, ok, err := issueStore.LastSync(); err != nil {
+			fmt.Printf("Error reading last sync: %v\n", err)
+			os.Exit(1)
+		} else if ok {
+			filter = fmt.Sprintf("(%s) AND updated >= %q", filter, last)
+		}
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		fmt.Printf("Error reading response: %v\n", err)
-		return
+	jql := filter
+	if !hasOrderBy {
+		jql += " ORDER BY created DESC"
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		fmt.Printf("Error: API returned status code %d\n", resp.StatusCode)
-		fmt.Printf("Response body: %s\n", string(body))
-		return
+	var fields []string
+	if *fieldsFlag != "" {
+		fields = strings.Split(*fieldsFlag, ",")
+	}
+
+	opts := jira.SearchOptions{Fields: fields}
+	if *allFlag {
+		opts.MaxResults = 0
+	} else {
+		opts.MaxResults = *maxFlag
 	}
 
-	var jiraResp JiraResponse
-	err = json.Unmarshal(body, &jiraResp)
+	issues, err := client.SearchIssues(ctx, jql, opts)
 	if err != nil {
-		fmt.Printf("Error parsing JSON: %v\n", err)
-		return
+		fmt.Printf("Error searching issues: %v\n", err)
+		os.Exit(1)
 	}
 
-	for _, issue := range jiraResp.Issues {
+	for _, issue := range issues {
 		fmt.Printf("Issue Key: %s\n", issue.Key)
 		fmt.Printf("Summary: %s\n", issue.Fields.Summary)
 		fmt.Printf("Status: %s\n", issue.Fields.Status.Name)
+		for _, f := range fields {
+			if raw, ok := issue.RawFields[f]; ok {
+				fmt.Printf("%s: %s\n", f, raw)
+			}
+		}
 		fmt.Printf("-------------------\n")
 	}
+
+	if issueStore != nil {
+		if err := issueStore.Save(issues); err != nil {
+			fmt.Printf("Error exporting issues: %v\n", err)
+			os.Exit(1)
+		}
+
+		if err := issueStore.SetLastSync(time.Now().UTC().Format("2006-01-02 15:04")); err != nil {
+			fmt.Printf("Error recording last sync: %v\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// runCreate reads a YAML or JSON issue descriptor from path (selected by
+// its ".yaml"/".yml" vs ".json" extension, defaulting to JSON), creates the
+// issue, and prints its key.
+func runCreate(ctx context.Context, client *jira.Client, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var input jira.CreateIssueInput
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &input); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+	default:
+		if err := json.Unmarshal(data, &input); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+	}
+
+	key, err := client.CreateIssue(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(key)
+	return nil
+}
+
+// buildAuthenticator reads the credentials for the selected auth method out
+// of the environment.
+func buildAuthenticator(method string) (jira.Authenticator, error) {
+	switch method {
+	case "basic":
+		email := os.Getenv("JIRA_EMAIL")
+		token := os.Getenv("JIRA_API_TOKEN")
+		if email == "" || token == "" {
+			return nil, fmt.Errorf("basic auth requires JIRA_EMAIL and JIRA_API_TOKEN")
+		}
+		return jira.BasicAuth{Email: email, Token: token}, nil
+
+	case "bearer":
+		pat := os.Getenv("JIRA_PAT")
+		if pat == "" {
+			return nil, fmt.Errorf("bearer auth requires JIRA_PAT")
+		}
+		return jira.BearerToken{Token: pat}, nil
+
+	case "oauth1":
+		consumerKey := os.Getenv("JIRA_OAUTH_CONSUMER_KEY")
+		accessToken := os.Getenv("JIRA_OAUTH_ACCESS_TOKEN")
+		accessSecret := os.Getenv("JIRA_OAUTH_ACCESS_SECRET")
+		if consumerKey == "" || accessToken == "" {
+			return nil, fmt.Errorf("oauth1 auth requires JIRA_OAUTH_CONSUMER_KEY and JIRA_OAUTH_ACCESS_TOKEN")
+		}
+
+		signatureMethod := os.Getenv("JIRA_OAUTH_SIGNATURE_METHOD")
+		if signatureMethod == "" {
+			signatureMethod = "RSA-SHA1"
+		}
+
+		auth := jira.OAuth1{
+			ConsumerKey:     consumerKey,
+			AccessToken:     accessToken,
+			AccessSecret:    accessSecret,
+			SignatureMethod: signatureMethod,
+		}
+
+		switch signatureMethod {
+		case "RSA-SHA1":
+			keyFile := os.Getenv("JIRA_OAUTH_PRIVATE_KEY_FILE")
+			if keyFile == "" {
+				return nil, fmt.Errorf("oauth1 with RSA-SHA1 requires JIRA_OAUTH_PRIVATE_KEY_FILE")
+			}
+
+			pemBytes, err := os.ReadFile(keyFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s: %w", keyFile, err)
+			}
+
+			auth.PrivateKey, err = jira.ParseRSAPrivateKeyPEM(pemBytes)
+			if err != nil {
+				return nil, err
+			}
+
+		case "HMAC-SHA1":
+			auth.ConsumerSecret = os.Getenv("JIRA_OAUTH_CONSUMER_SECRET")
+			if auth.ConsumerSecret == "" {
+				return nil, fmt.Errorf("oauth1 with HMAC-SHA1 requires JIRA_OAUTH_CONSUMER_SECRET")
+			}
+
+		default:
+			return nil, fmt.Errorf("unknown oauth1 signature method %q (want RSA-SHA1 or HMAC-SHA1)", signatureMethod)
+		}
+
+		return auth, nil
+
+	default:
+		return nil, fmt.Errorf("unknown auth method %q (want basic, bearer, or oauth1)", method)
+	}
 }
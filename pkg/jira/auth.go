@@ -0,0 +1,38 @@
+package jira
+
+import (
+	"encoding/base64"
+	"net/http"
+)
+
+// Authenticator adds credentials to an outgoing request. It's called once
+// per request, after query parameters have been set but before the request
+// is sent, so implementations that need to sign the full request (e.g.
+// OAuth1) see the final URL.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// BasicAuth authenticates with an email + API token, as used by Jira
+// Cloud.
+type BasicAuth struct {
+	Email string
+	Token string
+}
+
+func (b BasicAuth) Authenticate(req *http.Request) error {
+	auth := base64.StdEncoding.EncodeToString([]byte(b.Email + ":" + b.Token))
+	req.Header.Set("Authorization", "Basic "+auth)
+	return nil
+}
+
+// BearerToken authenticates with a Personal Access Token, as used by Jira
+// Data Center/Server.
+type BearerToken struct {
+	Token string
+}
+
+func (b BearerToken) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+b.Token)
+	return nil
+}
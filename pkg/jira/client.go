@@ -0,0 +1,207 @@
+// Package jira is a small client for the Jira REST API v2, used by
+// dev-context to pull issues into a local corpus.
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const defaultMaxRetries = 3
+
+// Client is a small wrapper around the Jira REST API v2. It is safe for
+// concurrent use as long as the underlying *http.Client is.
+type Client struct {
+	httpClient *http.Client
+	auth       Authenticator
+	baseURL    string
+	maxRetries int
+	limiter    *rateLimiter
+}
+
+// Option configures a Client returned by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the *http.Client used to make requests. Useful
+// for custom timeouts or test doubles. To instrument requests (e.g. with
+// OpenTelemetry) without losing the client's own timeout settings, prefer
+// WithTransport.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithTransport overrides the http.RoundTripper used by the client's
+// *http.Client, e.g. to plug in request instrumentation.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) {
+		c.httpClient.Transport = rt
+	}
+}
+
+// WithAuthenticator overrides how requests are authenticated. Without this
+// option, NewClient defaults to BasicAuth using the given email and token.
+func WithAuthenticator(auth Authenticator) Option {
+	return func(c *Client) {
+		c.auth = auth
+	}
+}
+
+// WithMaxRetries overrides how many times a request is retried after a 429
+// or transient 5xx response before giving up. The default is 3.
+func WithMaxRetries(n int) Option {
+	return func(c *Client) {
+		c.maxRetries = n
+	}
+}
+
+// WithRateLimit caps outbound requests to qps per second, client-side, so
+// bulk JQL exports against large projects don't trip Jira's own rate
+// limiting. A qps of 0 (the default) disables client-side limiting.
+func WithRateLimit(qps float64) Option {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(qps)
+	}
+}
+
+// NewClient returns a Client authenticated with Basic auth (email + API
+// token) against the given Jira Cloud/Server domain (e.g.
+// "yourcompany.atlassian.net"). Pass WithAuthenticator to use a different
+// auth scheme, such as BearerToken or OAuth1.
+func NewClient(email, token, domain string, opts ...Option) (*Client, error) {
+	if domain == "" {
+		return nil, fmt.Errorf("jira: domain is required")
+	}
+
+	c := &Client{
+		httpClient: &http.Client{},
+		auth:       BasicAuth{Email: email, Token: token},
+		baseURL:    fmt.Sprintf("https://%s/rest/api/2", domain),
+		maxRetries: defaultMaxRetries,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, query map[string]string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("jira: building request: %w", err)
+	}
+
+	if len(query) > 0 {
+		q := req.URL.Query()
+		for k, v := range query {
+			q.Set(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	if err := c.auth.Authenticate(req); err != nil {
+		return nil, fmt.Errorf("jira: authenticating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	return req, nil
+}
+
+// do executes req, retrying on 429 and transient 5xx responses (honoring
+// Jira's Retry-After header when present) with exponential backoff and
+// jitter. On a final 2xx response, it decodes the body into out (which may
+// be nil if the caller doesn't care about the response body). Any other
+// final response is returned as a *JiraAPIError.
+//
+// Retries only ever apply to idempotent requests (GET/HEAD). Jira has no
+// idempotency-key mechanism for issue/comment/transition writes, so
+// resending a POST after a transport error or a 5xx whose response was
+// lost risks creating a duplicate issue or comment, or replaying a
+// transition that's no longer valid from the issue's new state.
+func (c *Client) do(req *http.Request, out interface{}) error {
+	maxRetries := c.maxRetries
+	if !isIdempotent(req.Method) {
+		maxRetries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := rewindBody(req); err != nil {
+				return err
+			}
+		}
+
+		if err := c.limiter.wait(req.Context()); err != nil {
+			return fmt.Errorf("jira: rate limit wait: %w", err)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			if attempt >= maxRetries {
+				return fmt.Errorf("jira: request failed: %w", err)
+			}
+			if err := sleepWithContext(req.Context(), backoff(attempt)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return fmt.Errorf("jira: reading response body: %w", readErr)
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			apiErr := newAPIError(resp.StatusCode, body)
+			if isRetryableStatus(resp.StatusCode) && attempt < maxRetries {
+				if err := sleepWithContext(req.Context(), retryDelay(resp, attempt)); err != nil {
+					return err
+				}
+				continue
+			}
+			return apiErr
+		}
+
+		if out == nil || len(body) == 0 {
+			return nil
+		}
+
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("jira: decoding response: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// isIdempotent reports whether method is safe to automatically retry.
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// rewindBody resets req.Body before a retry, using the GetBody func the
+// stdlib populates automatically for *bytes.Reader/*bytes.Buffer/
+// *strings.Reader bodies (which is what every write-path method here
+// passes to newRequest).
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return fmt.Errorf("jira: rewinding request body for retry: %w", err)
+	}
+	req.Body = body
+
+	return nil
+}
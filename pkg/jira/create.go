@@ -0,0 +1,74 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateIssueInput describes the fields used to create a new issue via
+// CreateIssue. It can be unmarshalled directly from a YAML or JSON issue
+// descriptor (e.g. the file passed to the CLI's -create flag).
+type CreateIssueInput struct {
+	Project      string                 `json:"project" yaml:"project"`
+	IssueType    string                 `json:"issuetype" yaml:"issuetype"`
+	Summary      string                 `json:"summary" yaml:"summary"`
+	Description  string                 `json:"description" yaml:"description"`
+	Labels       []string               `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Components   []string               `json:"components,omitempty" yaml:"components,omitempty"`
+	Assignee     string                 `json:"assignee,omitempty" yaml:"assignee,omitempty"`
+	CustomFields map[string]interface{} `json:"customFields,omitempty" yaml:"customFields,omitempty"`
+}
+
+type createIssueResponse struct {
+	Key string `json:"key"`
+}
+
+// CreateIssue creates a new issue and returns its key (e.g. "ABC-124").
+func (c *Client) CreateIssue(ctx context.Context, input CreateIssueInput) (string, error) {
+	fields := map[string]interface{}{
+		"project":     map[string]string{"key": input.Project},
+		"issuetype":   map[string]string{"name": input.IssueType},
+		"summary":     input.Summary,
+		"description": input.Description,
+	}
+
+	if len(input.Labels) > 0 {
+		fields["labels"] = input.Labels
+	}
+
+	if len(input.Components) > 0 {
+		components := make([]map[string]string, len(input.Components))
+		for i, name := range input.Components {
+			components[i] = map[string]string{"name": name}
+		}
+		fields["components"] = components
+	}
+
+	if input.Assignee != "" {
+		fields["assignee"] = map[string]string{"name": input.Assignee}
+	}
+
+	for k, v := range input.CustomFields {
+		fields[k] = v
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"fields": fields})
+	if err != nil {
+		return "", fmt.Errorf("jira: encoding create issue payload: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/issue/", nil, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+
+	var created createIssueResponse
+	if err := c.do(req, &created); err != nil {
+		return "", fmt.Errorf("jira: create issue: %w", err)
+	}
+
+	return created.Key, nil
+}
@@ -0,0 +1,46 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// JiraAPIError represents a non-2xx response from the Jira REST API. Jira
+// error bodies typically look like:
+//
+//	{"errorMessages": ["..."], "errors": {"summary": "is required"}}
+type JiraAPIError struct {
+	StatusCode    int
+	ErrorMessages []string
+	Errors        map[string]string
+	Body          string
+}
+
+func (e *JiraAPIError) Error() string {
+	switch {
+	case len(e.ErrorMessages) > 0:
+		return fmt.Sprintf("jira: status %d: %s", e.StatusCode, strings.Join(e.ErrorMessages, "; "))
+	case len(e.Errors) > 0:
+		return fmt.Sprintf("jira: status %d: %v", e.StatusCode, e.Errors)
+	default:
+		return fmt.Sprintf("jira: status %d: %s", e.StatusCode, e.Body)
+	}
+}
+
+func newAPIError(status int, body []byte) *JiraAPIError {
+	apiErr := &JiraAPIError{StatusCode: status, Body: string(body)}
+
+	var parsed struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}
+	// Best-effort: Jira doesn't always return JSON (e.g. a proxy 502), in
+	// which case we fall back to the raw body above.
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		apiErr.ErrorMessages = parsed.ErrorMessages
+		apiErr.Errors = parsed.Errors
+	}
+
+	return apiErr
+}
@@ -0,0 +1,79 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Issue is a Jira issue as returned by the search and get-issue endpoints.
+type Issue struct {
+	Key    string      `json:"key"`
+	Fields IssueFields `json:"fields"`
+
+	// RawFields holds the undecoded JSON for every field Jira returned for
+	// this issue, keyed by field id (e.g. "assignee", "priority",
+	// "customfield_10010"). It's populated alongside Fields so callers that
+	// requested custom fields via SearchOptions.Fields can pull them out
+	// without dev-context needing a typed Go field for every possible Jira
+	// custom field.
+	RawFields map[string]json.RawMessage `json:"-"`
+}
+
+// IssueFields are the well-known fields dev-context understands out of the
+// box.
+type IssueFields struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+	Status      struct {
+		Name string `json:"name"`
+	} `json:"status"`
+	Assignee *struct {
+		DisplayName string `json:"displayName"`
+	} `json:"assignee"`
+	Updated string `json:"updated"`
+}
+
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	var shape struct {
+		Key    string          `json:"key"`
+		Fields json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &shape); err != nil {
+		return err
+	}
+
+	i.Key = shape.Key
+	if len(shape.Fields) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(shape.Fields, &i.Fields); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(shape.Fields, &i.RawFields)
+}
+
+type searchResponse struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	Issues     []Issue `json:"issues"`
+}
+
+// GetIssue fetches a single issue by its key (e.g. "ABC-123").
+func (c *Client) GetIssue(ctx context.Context, key string) (*Issue, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/issue/"+key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue Issue
+	if err := c.do(req, &issue); err != nil {
+		return nil, fmt.Errorf("jira: get issue %s: %w", key, err)
+	}
+
+	return &issue, nil
+}
@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestPercentEncode(t *testing.T) {
+	cases := map[string]string{
+		"abc123-._~": "abc123-._~",
+		"a b":        "a%20b",
+		"a+b":        "a%2Bb",
+		"a=b&c":      "a%3Db%26c",
+	}
+
+	for in, want := range cases {
+		if got := percentEncode(in); got != want {
+			t.Errorf("percentEncode(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSignatureBaseString(t *testing.T) {
+	u, err := url.Parse("https://example.atlassian.net/rest/api/2/issue/ABC-1/comment?expand=renderedBody")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &http.Request{Method: http.MethodPost, URL: u}
+
+	params := map[string]string{
+		"oauth_nonce":     "abc123",
+		"oauth_timestamp": "1000000000",
+	}
+
+	got := signatureBaseString(req, params)
+
+	wantPrefix := "POST&" + percentEncode("https://example.atlassian.net/rest/api/2/issue/ABC-1/comment") + "&"
+	if !strings.HasPrefix(got, wantPrefix) {
+		t.Fatalf("signatureBaseString = %q, want prefix %q", got, wantPrefix)
+	}
+
+	// Params (including the query param) must appear sorted by key.
+	paramPart := strings.TrimPrefix(got, wantPrefix)
+	decoded, err := url.QueryUnescape(paramPart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "expand=renderedBody&oauth_nonce=abc123&oauth_timestamp=1000000000"
+	if decoded != want {
+		t.Errorf("decoded params = %q, want %q", decoded, want)
+	}
+}
+
+func TestBuildOAuthHeader(t *testing.T) {
+	params := map[string]string{
+		"oauth_nonce":     "abc",
+		"oauth_signature": "a+b",
+		"oauth_token":     "tok",
+	}
+
+	got := buildOAuthHeader(params)
+
+	if !strings.HasPrefix(got, "OAuth ") {
+		t.Fatalf("header = %q, want OAuth prefix", got)
+	}
+
+	// Keys must be sorted and the signature percent-encoded, not raw.
+	want := `OAuth oauth_nonce="abc", oauth_signature="a%2Bb", oauth_token="tok"`
+	if got != want {
+		t.Errorf("buildOAuthHeader = %q, want %q", got, want)
+	}
+}
+
+func TestOAuth1AuthenticateHMACSHA1(t *testing.T) {
+	auth := OAuth1{
+		ConsumerKey:     "consumer-key",
+		ConsumerSecret:  "consumer-secret",
+		AccessToken:     "access-token",
+		AccessSecret:    "access-secret",
+		SignatureMethod: "HMAC-SHA1",
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.atlassian.net/rest/api/2/search", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := auth.Authenticate(req); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+
+	header := req.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "OAuth ") {
+		t.Fatalf("Authorization header = %q, want OAuth prefix", header)
+	}
+
+	sigMatch := regexp.MustCompile(`oauth_signature="([^"]+)"`).FindStringSubmatch(header)
+	if sigMatch == nil {
+		t.Fatalf("Authorization header missing oauth_signature: %q", header)
+	}
+	gotSig, err := url.QueryUnescape(sigMatch[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nonceMatch := regexp.MustCompile(`oauth_nonce="([^"]+)"`).FindStringSubmatch(header)
+	timestampMatch := regexp.MustCompile(`oauth_timestamp="([^"]+)"`).FindStringSubmatch(header)
+	if nonceMatch == nil || timestampMatch == nil {
+		t.Fatalf("Authorization header missing nonce/timestamp: %q", header)
+	}
+
+	wantParams := map[string]string{
+		"oauth_consumer_key":     auth.ConsumerKey,
+		"oauth_token":            auth.AccessToken,
+		"oauth_signature_method": auth.SignatureMethod,
+		"oauth_timestamp":        timestampMatch[1],
+		"oauth_nonce":            nonceMatch[1],
+		"oauth_version":          "1.0",
+	}
+	base := signatureBaseString(req, wantParams)
+
+	key := percentEncode(auth.ConsumerSecret) + "&" + percentEncode(auth.AccessSecret)
+	mac := hmac.New(sha1.New, []byte(key))
+	mac.Write([]byte(base))
+	wantSig := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	if gotSig != wantSig {
+		t.Errorf("oauth_signature = %q, want %q", gotSig, wantSig)
+	}
+}
+
+func TestOAuth1AuthenticateRSASHA1MissingKey(t *testing.T) {
+	auth := OAuth1{ConsumerKey: "k", AccessToken: "t"}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.atlassian.net/rest/api/2/search", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := auth.Authenticate(req); err == nil {
+		t.Fatal("Authenticate: want error when PrivateKey is nil for RSA-SHA1, got nil")
+	}
+}
@@ -0,0 +1,29 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Project is a subset of the fields returned by GET /rest/api/2/project/{key}.
+type Project struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// GetProject fetches a single project by its key (e.g. "ABC").
+func (c *Client) GetProject(ctx context.Context, key string) (*Project, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/project/"+key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if err := c.do(req, &project); err != nil {
+		return nil, fmt.Errorf("jira: get project %s: %w", key, err)
+	}
+
+	return &project, nil
+}
@@ -0,0 +1,47 @@
+package jira
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a minimal token-bucket limiter that spaces requests at
+// least 1/qps apart. It's hand-rolled rather than built on
+// golang.org/x/time/rate, so the module doesn't depend on that package.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(qps float64) *rateLimiter {
+	if qps <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / qps)}
+}
+
+// wait blocks until the limiter allows another request, or ctx is done. A
+// nil *rateLimiter (the default, meaning "unlimited") never blocks.
+func (r *rateLimiter) wait(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	now := time.Now()
+	if r.next.Before(now) {
+		r.next = now
+	}
+	delay := r.next.Sub(now)
+	r.next = r.next.Add(r.interval)
+	r.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	return sleepWithContext(ctx, delay)
+}
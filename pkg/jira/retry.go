@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || (status >= 500 && status < 600)
+}
+
+// retryDelay honors Jira's Retry-After header (seconds or an HTTP date)
+// when present, falling back to exponential backoff with jitter.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		if secs, err := strconv.Atoi(ra); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if when, err := http.ParseTime(ra); err == nil {
+			if d := time.Until(when); d > 0 {
+				return d
+			}
+		}
+	}
+
+	return backoff(attempt)
+}
+
+// backoff returns an exponentially increasing delay (250ms * 2^attempt)
+// plus up to that much jitter, to avoid every client retrying in lockstep.
+func backoff(attempt int) time.Duration {
+	base := 250 * time.Millisecond * time.Duration(1<<uint(attempt))
+	jitter := time.Duration(rand.Int63n(int64(base) + 1))
+	return base + jitter
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
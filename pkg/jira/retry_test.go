@@ -0,0 +1,76 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableStatus(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusOK:                  false,
+		http.StatusBadRequest:          false,
+		http.StatusUnauthorized:        false,
+		http.StatusNotFound:            false,
+		http.StatusTooManyRequests:     true,
+		http.StatusInternalServerError: true,
+		http.StatusBadGateway:          true,
+		http.StatusServiceUnavailable:  true,
+		599:                            true,
+		600:                            false,
+	}
+
+	for status, want := range cases {
+		if got := isRetryableStatus(status); got != want {
+			t.Errorf("isRetryableStatus(%d) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestRetryDelayHonorsRetryAfterSeconds(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+
+	got := retryDelay(resp, 0)
+	if got != 2*time.Second {
+		t.Errorf("retryDelay = %v, want 2s", got)
+	}
+}
+
+func TestRetryDelayHonorsRetryAfterHTTPDate(t *testing.T) {
+	when := time.Now().Add(5 * time.Second)
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{when.UTC().Format(http.TimeFormat)}}}
+
+	got := retryDelay(resp, 0)
+	if got <= 0 || got > 6*time.Second {
+		t.Errorf("retryDelay = %v, want roughly 5s", got)
+	}
+}
+
+func TestRetryDelayFallsBackToBackoff(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+
+	got := retryDelay(resp, 0)
+	if got < 250*time.Millisecond || got > 500*time.Millisecond {
+		t.Errorf("retryDelay = %v, want between 250ms and 500ms for attempt 0", got)
+	}
+}
+
+func TestBackoffGrowsWithAttempt(t *testing.T) {
+	for attempt := 0; attempt < 4; attempt++ {
+		base := 250 * time.Millisecond * time.Duration(1<<uint(attempt))
+		got := backoff(attempt)
+		if got < base || got > 2*base {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", attempt, got, base, 2*base)
+		}
+	}
+}
+
+func TestSleepWithContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := sleepWithContext(ctx, time.Second); err == nil {
+		t.Fatal("sleepWithContext: want error for an already-cancelled context, got nil")
+	}
+}
@@ -0,0 +1,76 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const defaultPageSize = 50
+
+// SearchOptions controls how SearchIssues queries and pages through
+// results.
+type SearchOptions struct {
+	// MaxResults caps the total number of issues returned. If zero,
+	// SearchIssues pages through the JQL query until every matching issue
+	// has been fetched.
+	MaxResults int
+
+	// Fields, if set, is passed through to Jira's `fields` query param so
+	// only the listed fields (e.g. "assignee,priority,labels") are returned
+	// per issue. If empty, Jira's default field set is used.
+	Fields []string
+}
+
+// SearchIssues runs a JQL query, transparently following Jira's
+// startAt/maxResults pagination until either every matching issue has been
+// retrieved or opts.MaxResults has been reached.
+func (c *Client) SearchIssues(ctx context.Context, jql string, opts SearchOptions) ([]Issue, error) {
+	pageSize := opts.MaxResults
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = defaultPageSize
+	}
+
+	var (
+		all     []Issue
+		startAt int
+	)
+
+	query := map[string]string{
+		"jql":        jql,
+		"startAt":    fmt.Sprintf("%d", startAt),
+		"maxResults": fmt.Sprintf("%d", pageSize),
+	}
+	if len(opts.Fields) > 0 {
+		query["fields"] = strings.Join(opts.Fields, ",")
+	}
+
+	for {
+		query["startAt"] = fmt.Sprintf("%d", startAt)
+
+		req, err := c.newRequest(ctx, http.MethodGet, "/search", query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page searchResponse
+		if err := c.do(req, &page); err != nil {
+			return nil, fmt.Errorf("jira: search issues: %w", err)
+		}
+
+		all = append(all, page.Issues...)
+		startAt += len(page.Issues)
+
+		if opts.MaxResults > 0 && len(all) >= opts.MaxResults {
+			all = all[:opts.MaxResults]
+			break
+		}
+
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			break
+		}
+	}
+
+	return all, nil
+}
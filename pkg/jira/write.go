@@ -0,0 +1,102 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Transition is one of the states an issue can move to from its current
+// status, as returned by GET /issue/{key}/transitions.
+type Transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   struct {
+		Name string `json:"name"`
+	} `json:"to"`
+}
+
+type transitionsResponse struct {
+	Transitions []Transition `json:"transitions"`
+}
+
+// AddComment posts a plain-text comment to an issue.
+func (c *Client) AddComment(ctx context.Context, key, body string) error {
+	payload, err := json.Marshal(map[string]string{"body": body})
+	if err != nil {
+		return fmt.Errorf("jira: encoding comment payload: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/issue/"+key+"/comment", nil, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	if err := c.do(req, nil); err != nil {
+		return fmt.Errorf("jira: add comment to %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// Transitions lists the states an issue can currently move to.
+func (c *Client) Transitions(ctx context.Context, key string) ([]Transition, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/issue/"+key+"/transitions", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp transitionsResponse
+	if err := c.do(req, &resp); err != nil {
+		return nil, fmt.Errorf("jira: list transitions for %s: %w", key, err)
+	}
+
+	return resp.Transitions, nil
+}
+
+// TransitionIssue moves an issue to the named target status (e.g. "In
+// Progress"), matched case-insensitively against the available
+// transitions. It returns a descriptive error, including the set of
+// statuses the issue can actually move to, if toStatus isn't reachable
+// from the issue's current status.
+func (c *Client) TransitionIssue(ctx context.Context, key, toStatus string) error {
+	transitions, err := c.Transitions(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	var id string
+	available := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		available = append(available, t.To.Name)
+		if strings.EqualFold(t.To.Name, toStatus) {
+			id = t.ID
+			break
+		}
+	}
+
+	if id == "" {
+		return fmt.Errorf("jira: transition to %q is not available for issue %s (available: %s)", toStatus, key, strings.Join(available, ", "))
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"transition": map[string]string{"id": id},
+	})
+	if err != nil {
+		return fmt.Errorf("jira: encoding transition payload: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/issue/"+key+"/transitions", nil, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	if err := c.do(req, nil); err != nil {
+		return fmt.Errorf("jira: transition %s to %q: %w", key, toStatus, err)
+	}
+
+	return nil
+}
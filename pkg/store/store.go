@@ -0,0 +1,146 @@
+// Package store persists issues fetched via pkg/jira to a local directory,
+// so dev-context can build a corpus to feed into downstream tooling.
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/adavila0703/dev-context/pkg/jira"
+)
+
+const lastSyncFile = ".last-sync"
+
+// IssueStore writes fetched issues to disk as a combined JSON index plus
+// one Markdown file per issue.
+type IssueStore struct {
+	dir string
+}
+
+// NewIssueStore returns an IssueStore rooted at dir, creating dir if it
+// doesn't already exist.
+func NewIssueStore(dir string) (*IssueStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("store: creating export dir: %w", err)
+	}
+
+	return &IssueStore{dir: dir}, nil
+}
+
+// Save merges issues into the combined issues.json index (replacing any
+// existing entry with the same key) and writes one ISSUE-KEY.md file per
+// issue into the store's directory. Because incremental syncs only pass in
+// issues that changed since the last run, issues.json must be merged rather
+// than overwritten, or unchanged issues from earlier syncs would silently
+// drop out of the index.
+func (s *IssueStore) Save(issues []jira.Issue) error {
+	existing, err := s.loadIndex()
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		existing[issue.Key] = issue
+	}
+
+	merged := make([]jira.Issue, 0, len(existing))
+	for _, issue := range existing {
+		merged = append(merged, issue)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Key < merged[j].Key })
+
+	index, err := json.MarshalIndent(merged, "", "  ")
+	if err != nil {
+		return fmt.Errorf("store: encoding issues.json: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(s.dir, "issues.json"), index, 0o644); err != nil {
+		return fmt.Errorf("store: writing issues.json: %w", err)
+	}
+
+	for _, issue := range issues {
+		if err := s.writeMarkdown(issue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadIndex reads the existing issues.json index, keyed by issue key. It
+// returns an empty map (not an error) if no index has been written yet.
+func (s *IssueStore) loadIndex() (map[string]jira.Issue, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, "issues.json"))
+	if os.IsNotExist(err) {
+		return map[string]jira.Issue{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store: reading issues.json: %w", err)
+	}
+
+	var issues []jira.Issue
+	if err := json.Unmarshal(data, &issues); err != nil {
+		return nil, fmt.Errorf("store: decoding issues.json: %w", err)
+	}
+
+	byKey := make(map[string]jira.Issue, len(issues))
+	for _, issue := range issues {
+		byKey[issue.Key] = issue
+	}
+
+	return byKey, nil
+}
+
+func (s *IssueStore) writeMarkdown(issue jira.Issue) error {
+	assignee := ""
+	if issue.Fields.Assignee != nil {
+		assignee = issue.Fields.Assignee.DisplayName
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	fmt.Fprintf(&buf, "summary: %q\n", issue.Fields.Summary)
+	fmt.Fprintf(&buf, "status: %q\n", issue.Fields.Status.Name)
+	fmt.Fprintf(&buf, "assignee: %q\n", assignee)
+	fmt.Fprintf(&buf, "updated: %q\n", issue.Fields.Updated)
+	buf.WriteString("---\n\n")
+	buf.WriteString(issue.Fields.Description)
+	buf.WriteString("\n")
+
+	path := filepath.Join(s.dir, issue.Key+".md")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("store: writing %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// LastSync returns the timestamp recorded by the previous SetLastSync call
+// (in Jira's JQL date format, e.g. "2006-01-02 15:04"). The second return
+// value is false if no sync has completed yet.
+func (s *IssueStore) LastSync() (string, bool, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, lastSyncFile))
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("store: reading %s: %w", lastSyncFile, err)
+	}
+
+	return strings.TrimSpace(string(data)), true, nil
+}
+
+// SetLastSync records ts as the time of the most recent successful sync.
+func (s *IssueStore) SetLastSync(ts string) error {
+	path := filepath.Join(s.dir, lastSyncFile)
+	if err := os.WriteFile(path, []byte(ts+"\n"), 0o644); err != nil {
+		return fmt.Errorf("store: writing %s: %w", lastSyncFile, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adavila0703/dev-context/pkg/jira"
+)
+
+func issueWithSummary(key, summary string) jira.Issue {
+	data := []byte(`{"key":"` + key + `","fields":{"summary":"` + summary + `"}}`)
+	var issue jira.Issue
+	if err := json.Unmarshal(data, &issue); err != nil {
+		panic(err)
+	}
+	return issue
+}
+
+func TestSaveMergesIncrementalSyncIntoIndex(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewIssueStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	full := []jira.Issue{
+		issueWithSummary("ABC-1", "first"),
+		issueWithSummary("ABC-2", "second"),
+		issueWithSummary("ABC-3", "third"),
+	}
+	if err := s.Save(full); err != nil {
+		t.Fatalf("Save (full sync): %v", err)
+	}
+
+	// An incremental sync only fetches the one issue that changed.
+	incremental := []jira.Issue{issueWithSummary("ABC-2", "second, updated")}
+	if err := s.Save(incremental); err != nil {
+		t.Fatalf("Save (incremental sync): %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "issues.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []jira.Issue
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("issues.json has %d issues, want 3 (ABC-1 and ABC-3 should survive the incremental sync)", len(got))
+	}
+
+	byKey := make(map[string]string, len(got))
+	for _, issue := range got {
+		byKey[issue.Key] = issue.Fields.Summary
+	}
+
+	if byKey["ABC-1"] != "first" {
+		t.Errorf("ABC-1 summary = %q, want %q", byKey["ABC-1"], "first")
+	}
+	if byKey["ABC-2"] != "second, updated" {
+		t.Errorf("ABC-2 summary = %q, want %q", byKey["ABC-2"], "second, updated")
+	}
+	if byKey["ABC-3"] != "third" {
+		t.Errorf("ABC-3 summary = %q, want %q", byKey["ABC-3"], "third")
+	}
+}